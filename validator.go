@@ -4,26 +4,59 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"unicode/utf8"
 )
 
 const (
 	tagName = "validate"
 
-	lenTag = "len"
-	inTag  = "in"
-	minTag = "min"
-	maxTag = "max"
+	// ruleSep separates multiple rules within a single validate tag,
+	// e.g. `validate:"min:3;max:20"`. A comma is not used here since it
+	// already separates the values of the in tag.
+	ruleSep = ";"
+
+	lenTag      = "len"
+	inTag       = "in"
+	minTag      = "min"
+	maxTag      = "max"
+	regexpTag   = "regexp"
+	requiredTag = "required"
+
+	// omitEmptyTag opts a field out of every other rule in its tag (but not
+	// required/requiredif, which already have their own zero-value handling)
+	// when the field holds its zero value, e.g. `validate:"omitempty;min:3"`.
+	omitEmptyTag = "omitempty"
+
+	eqFieldTag    = "eqfield"
+	neFieldTag    = "nefield"
+	gtFieldTag    = "gtfield"
+	requiredIfTag = "requiredif"
 )
 
 var ErrNotStruct = errors.New("wrong argument given, should be a struct")
 var ErrInvalidValidatorSyntax = errors.New("invalid validator syntax")
 var ErrValidateForUnexportedFields = errors.New("validation for unexported field is not allowed")
+var ErrRequired = errors.New("field is required")
 
 type ValidationError struct {
-	Err error
+	Field string
+	Rule  string
+	Err   error
+}
+
+func (v ValidationError) Error() string {
+	switch {
+	case v.Field != "" && v.Rule != "":
+		return fmt.Sprintf("%s: %s: %s", v.Field, v.Rule, v.Err.Error())
+	case v.Field != "":
+		return fmt.Sprintf("%s: %s", v.Field, v.Err.Error())
+	default:
+		return v.Err.Error()
+	}
 }
 
 type ValidationErrors []ValidationError
@@ -32,10 +65,10 @@ func (v ValidationErrors) Error() string {
 	var sb strings.Builder
 
 	for _, verr := range v {
-		sb.WriteString(fmt.Sprintf("%s: ", verr.Err.Error()))
+		sb.WriteString(fmt.Sprintf("%s; ", verr.Error()))
 	}
 
-	return strings.TrimSuffix(sb.String(), ": ")
+	return strings.TrimSuffix(sb.String(), "; ")
 }
 
 func (v ValidationErrors) Is(target error) bool {
@@ -47,28 +80,99 @@ func (v ValidationErrors) Is(target error) bool {
 	return false
 }
 
-type validationFunc func(value reflect.Value) error
+type validationFunc func(value reflect.Value, parent reflect.Value) error
+
+// ValidationFunc is the function signature expected by RegisterValidator. It
+// is the same shape used internally for the built-in rules, exposed so
+// custom validators can be added without forking the package. parent is the
+// reflect.Value of the struct enclosing the field being validated, letting a
+// custom rule compare against a sibling field the way eqfield/gtfield do.
+type ValidationFunc = validationFunc
+
+var (
+	customValidatorsMu sync.RWMutex
+	customValidators   = map[string]func(param string) (ValidationFunc, error){}
+)
+
+// RegisterValidator adds a custom rule that can be referenced by name from a
+// validate tag, e.g. RegisterValidator("email", ...) enables
+// `validate:"email"`. factory is called with the rule's parameter (empty
+// string if the rule was used without one) each time a tag using the rule is
+// parsed, which happens on every validation of the field, and should return
+// ErrInvalidValidatorSyntax for a malformed parameter. Registering a name
+// that collides with a built-in rule (len, in, min, max, regexp, required,
+// eqfield, nefield, gtfield, requiredif) shadows it; omitempty cannot be
+// shadowed.
+func RegisterValidator(name string, factory func(param string) (ValidationFunc, error)) {
+	customValidatorsMu.Lock()
+	defer customValidatorsMu.Unlock()
+
+	customValidators[name] = factory
+}
+
+func lookupCustomValidator(name string) (func(param string) (ValidationFunc, error), bool) {
+	customValidatorsMu.RLock()
+	defer customValidatorsMu.RUnlock()
+
+	factory, ok := customValidators[name]
+	return factory, ok
+}
 
-func getLenValidationFunc(length int) validationFunc {
-	return func(value reflect.Value) error {
+func getLenValidationFunc(param string) validationFunc {
+	return func(value reflect.Value, parent reflect.Value) error {
 		switch value.Kind() {
 		case reflect.String:
+			length, err := strconv.Atoi(param)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
 			if utf8.RuneCountInString(value.String()) != length {
 				return errors.New("invalid length")
 			}
 			return nil
 		case reflect.Slice, reflect.Array:
+			length, err := strconv.Atoi(param)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
 			if value.Len() != length {
 				return errors.New("invalid length")
 			}
 			return nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			length, err := strconv.ParseInt(param, 0, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
+			if value.Int() != length {
+				return errors.New("invalid length")
+			}
+			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			length, err := strconv.ParseUint(param, 0, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
+			if value.Uint() != length {
+				return errors.New("invalid length")
+			}
+			return nil
+		case reflect.Float32, reflect.Float64:
+			length, err := strconv.ParseFloat(param, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
+			if value.Float() != length {
+				return errors.New("invalid length")
+			}
+			return nil
 		}
 		return errors.New("invalid type of field for tag len")
 	}
 }
 
 func getInValidationFunc(strs []string) validationFunc {
-	return func(value reflect.Value) error {
+	return func(value reflect.Value, parent reflect.Value) error {
 		switch value.Kind() {
 		case reflect.String:
 			for _, str := range strs {
@@ -77,14 +181,14 @@ func getInValidationFunc(strs []string) validationFunc {
 				}
 			}
 			return errors.New("field value is not in array from tag")
-		case reflect.Int:
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 			in := make([]int64, 0, len(strs))
 			for _, str := range strs {
-				value, err := strconv.ParseInt(strings.TrimSpace(str), 0, 64)
+				parsed, err := strconv.ParseInt(strings.TrimSpace(str), 0, 64)
 				if err != nil {
 					return ErrInvalidValidatorSyntax
 				}
-				in = append(in, value)
+				in = append(in, parsed)
 			}
 
 			for _, v := range in {
@@ -93,167 +197,517 @@ func getInValidationFunc(strs []string) validationFunc {
 				}
 			}
 			return errors.New("field value is not in array from tag")
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			in := make([]uint64, 0, len(strs))
+			for _, str := range strs {
+				parsed, err := strconv.ParseUint(strings.TrimSpace(str), 0, 64)
+				if err != nil {
+					return ErrInvalidValidatorSyntax
+				}
+				in = append(in, parsed)
+			}
+
+			for _, v := range in {
+				if v == value.Uint() {
+					return nil
+				}
+			}
+			return errors.New("field value is not in array from tag")
+		case reflect.Float32, reflect.Float64:
+			in := make([]float64, 0, len(strs))
+			for _, str := range strs {
+				parsed, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+				if err != nil {
+					return ErrInvalidValidatorSyntax
+				}
+				in = append(in, parsed)
+			}
+
+			for _, v := range in {
+				if v == value.Float() {
+					return nil
+				}
+			}
+			return errors.New("field value is not in array from tag")
 		}
 		return errors.New("invalid type of field for tag in")
 	}
 }
 
-func getMinValidationFunc(min int64) validationFunc {
-	return func(value reflect.Value) error {
+func getMinValidationFunc(param string) validationFunc {
+	return func(value reflect.Value, parent reflect.Value) error {
 		switch value.Kind() {
 		case reflect.String:
+			min, err := strconv.ParseInt(param, 0, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
 			if int64(utf8.RuneCountInString(value.String())) < min {
 				return errors.New("string length less than min")
 			}
 			return nil
 		case reflect.Slice, reflect.Array:
+			min, err := strconv.ParseInt(param, 0, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
 			if int64(value.Len()) < min {
 				return errors.New("slice length less than min")
 			}
 			return nil
-		case reflect.Int:
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			min, err := strconv.ParseInt(param, 0, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
 			if value.Int() < min {
 				return errors.New("int value less than min")
 			}
 			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			min, err := strconv.ParseUint(param, 0, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
+			if value.Uint() < min {
+				return errors.New("uint value less than min")
+			}
+			return nil
+		case reflect.Float32, reflect.Float64:
+			min, err := strconv.ParseFloat(param, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
+			if value.Float() < min {
+				return errors.New("float value less than min")
+			}
+			return nil
 		}
 		return errors.New("invalid type of field for tag min")
 	}
 }
 
-func getMaxValidationFunc(max int64) validationFunc {
-	return func(value reflect.Value) error {
+func getMaxValidationFunc(param string) validationFunc {
+	return func(value reflect.Value, parent reflect.Value) error {
 		switch value.Kind() {
 		case reflect.String:
+			max, err := strconv.ParseInt(param, 0, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
 			if int64(utf8.RuneCountInString(value.String())) > max {
 				return errors.New("string length greater than max")
 			}
 			return nil
 		case reflect.Slice, reflect.Array:
+			max, err := strconv.ParseInt(param, 0, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
 			if int64(value.Len()) > max {
 				return errors.New("slice length greater than max")
 			}
 			return nil
-		case reflect.Int:
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			max, err := strconv.ParseInt(param, 0, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
 			if value.Int() > max {
 				return errors.New("int value greater than max")
 			}
 			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			max, err := strconv.ParseUint(param, 0, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
+			if value.Uint() > max {
+				return errors.New("uint value greater than max")
+			}
+			return nil
+		case reflect.Float32, reflect.Float64:
+			max, err := strconv.ParseFloat(param, 64)
+			if err != nil {
+				return ErrInvalidValidatorSyntax
+			}
+			if value.Float() > max {
+				return errors.New("float value greater than max")
+			}
+			return nil
 		}
 		return errors.New("invalid type of field for tag max")
 	}
 }
 
-func getValidationFunc(funcName string, param string) (validationFunc, error) {
+// getRequiredValidationFunc builds the validationFunc for the required tag.
+// param is either empty (bare `required`), or a bool string; `required:false`
+// is accepted so a field can be explicitly marked optional.
+func getRequiredValidationFunc(param string) (validationFunc, error) {
+	required := true
+	if param != "" {
+		b, err := strconv.ParseBool(param)
+		if err != nil {
+			return nil, ErrInvalidValidatorSyntax
+		}
+		required = b
+	}
+
+	return func(value reflect.Value, parent reflect.Value) error {
+		if required && value.IsZero() {
+			return ErrRequired
+		}
+		return nil
+	}, nil
+}
+
+func getRegexpValidationFunc(re *regexp.Regexp) validationFunc {
+	return func(value reflect.Value, parent reflect.Value) error {
+		if value.Kind() != reflect.String {
+			return errors.New("invalid type of field for tag regexp")
+		}
+		if !re.MatchString(value.String()) {
+			return errors.New("string does not match pattern")
+		}
+		return nil
+	}
+}
+
+func getValidationFunc(funcName string, param string, fieldName string) (validationFunc, error) {
 	funcName = strings.TrimSpace(funcName)
 	param = strings.TrimSpace(param)
+
+	if factory, ok := lookupCustomValidator(funcName); ok {
+		return factory(param)
+	}
+
+	if funcName == requiredTag {
+		return getRequiredValidationFunc(param)
+	}
+
 	if param == "" {
 		return nil, ErrInvalidValidatorSyntax
 	}
 
 	switch funcName {
 	case lenTag:
-		length, err := strconv.Atoi(param)
-		if err != nil {
-			return nil, ErrInvalidValidatorSyntax
-		}
-		return getLenValidationFunc(length), nil
+		return getLenValidationFunc(param), nil
 	case inTag:
 		strs := strings.Split(param, ",")
 
 		return getInValidationFunc(strs), nil
 	case minTag:
-		min, err := strconv.ParseInt(param, 0, 64)
-		if err != nil {
-			return nil, ErrInvalidValidatorSyntax
-		}
-		return getMinValidationFunc(min), nil
+		return getMinValidationFunc(param), nil
 	case maxTag:
-		max, err := strconv.ParseInt(param, 0, 64)
+		return getMaxValidationFunc(param), nil
+	case regexpTag:
+		re, err := regexp.Compile(param)
 		if err != nil {
 			return nil, ErrInvalidValidatorSyntax
 		}
-		return getMaxValidationFunc(max), nil
+		return getRegexpValidationFunc(re), nil
+	case eqFieldTag:
+		return getEqFieldValidationFunc(param), nil
+	case neFieldTag:
+		return getNeFieldValidationFunc(param), nil
+	case gtFieldTag:
+		return getGtFieldValidationFunc(param, fieldName), nil
+	case requiredIfTag:
+		return getRequiredIfValidationFunc(param)
 	}
 	return nil, ErrInvalidValidatorSyntax
 }
 
+// lookupSiblingField resolves name against the enclosing struct passed as
+// parent to a validationFunc. It returns ErrInvalidValidatorSyntax if parent
+// isn't a struct or has no such field, mirroring how a bad len/min/max
+// parameter is reported, and ErrValidateForUnexportedFields if the field
+// exists but is unexported: reflect forbids calling Interface() on a value
+// obtained through an unexported field, which callers of this function need
+// to do, so returning the Value as-is would only defer the panic.
+func lookupSiblingField(parent reflect.Value, name string) (reflect.Value, error) {
+	if parent.Kind() != reflect.Struct {
+		return reflect.Value{}, ErrInvalidValidatorSyntax
+	}
+
+	sf, ok := parent.Type().FieldByName(name)
+	if !ok {
+		return reflect.Value{}, ErrInvalidValidatorSyntax
+	}
+	if !sf.Anonymous && sf.PkgPath != "" {
+		return reflect.Value{}, ErrValidateForUnexportedFields
+	}
+
+	return parent.FieldByName(name), nil
+}
+
+func getEqFieldValidationFunc(fieldName string) validationFunc {
+	return func(value reflect.Value, parent reflect.Value) error {
+		sibling, err := lookupSiblingField(parent, fieldName)
+		if err != nil {
+			return err
+		}
+		if !reflect.DeepEqual(value.Interface(), sibling.Interface()) {
+			return fmt.Errorf("must equal field %s", fieldName)
+		}
+		return nil
+	}
+}
+
+func getNeFieldValidationFunc(fieldName string) validationFunc {
+	return func(value reflect.Value, parent reflect.Value) error {
+		sibling, err := lookupSiblingField(parent, fieldName)
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(value.Interface(), sibling.Interface()) {
+			return fmt.Errorf("must not equal field %s", fieldName)
+		}
+		return nil
+	}
+}
+
+func getGtFieldValidationFunc(siblingName string, currentFieldName string) validationFunc {
+	return func(value reflect.Value, parent reflect.Value) error {
+		sibling, err := lookupSiblingField(parent, siblingName)
+		if err != nil {
+			return err
+		}
+		if sibling.Kind() != value.Kind() {
+			return fmt.Errorf("field %s is not comparable to field %s", currentFieldName, siblingName)
+		}
+
+		switch value.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if value.Int() <= sibling.Int() {
+				return fmt.Errorf("must be greater than field %s", siblingName)
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if value.Uint() <= sibling.Uint() {
+				return fmt.Errorf("must be greater than field %s", siblingName)
+			}
+		case reflect.Float32, reflect.Float64:
+			if value.Float() <= sibling.Float() {
+				return fmt.Errorf("must be greater than field %s", siblingName)
+			}
+		case reflect.String:
+			if value.String() <= sibling.String() {
+				return fmt.Errorf("must be greater than field %s", siblingName)
+			}
+		default:
+			return fmt.Errorf("invalid type of field for tag %s", gtFieldTag)
+		}
+		return nil
+	}
+}
+
+// getRequiredIfValidationFunc implements `requiredif:OtherField:value`: the
+// field is required only when the sibling named OtherField currently holds
+// value (compared via its formatted string representation).
+func getRequiredIfValidationFunc(param string) (validationFunc, error) {
+	parts := strings.SplitN(param, ":", 2)
+	if len(parts) != 2 {
+		return nil, ErrInvalidValidatorSyntax
+	}
+
+	siblingName := strings.TrimSpace(parts[0])
+	want := strings.TrimSpace(parts[1])
+
+	return func(value reflect.Value, parent reflect.Value) error {
+		sibling, err := lookupSiblingField(parent, siblingName)
+		if err != nil {
+			return err
+		}
+		if fmt.Sprint(sibling.Interface()) != want {
+			return nil
+		}
+		if value.IsZero() {
+			return ErrRequired
+		}
+		return nil
+	}, nil
+}
+
 func Validate(v any) error {
-	if errs := deepValidate(reflect.ValueOf(v), nil); len(errs) > 0 {
+	if errs := deepValidate(reflect.ValueOf(v), "", nil); len(errs) > 0 {
 		return errs
 	}
 
 	return nil
 }
 
-func deepValidate(value reflect.Value, errs ValidationErrors) ValidationErrors {
+// deepValidate walks v, descending into pointers, structs, slices/arrays and
+// maps of validatable elements. path is the dotted/indexed location of value
+// within the root passed to Validate (empty at the root) and is extended,
+// never mutated, as the walk descends so each recursive call owns its own
+// copy. A value whose kind isn't walkable is reported as ErrNotStruct, since
+// deepValidate is only entered from Validate, whose argument is expected to
+// be validatable; fields are walked through walkValue directly.
+func deepValidate(value reflect.Value, path string, errs ValidationErrors) ValidationErrors {
+	return walkValue(value, path, true, errs)
+}
+
+// walkValue is deepValidate's workhorse, shared with the per-field recursion
+// in validateField. strict controls what happens when value's kind has no
+// nested validatable content: deepValidate wants ErrNotStruct (the root
+// passed to Validate must itself be validatable), while validateField wants
+// to silently skip plain leaf fields (string, int, []string, ...) that
+// simply aren't containers of structs.
+func walkValue(value reflect.Value, path string, strict bool, errs ValidationErrors) ValidationErrors {
 	switch value.Kind() {
 	case reflect.Ptr:
 		if value.IsNil() {
 			return errs
 		}
 
-		return deepValidate(value.Elem(), errs)
+		return walkValue(value.Elem(), path, strict, errs)
 	case reflect.Struct:
-		return validateStruct(value, errs)
+		return validateStruct(value, path, errs)
 	case reflect.Array, reflect.Slice:
 		switch value.Type().Elem().Kind() {
-		case reflect.Struct, reflect.Ptr, reflect.Array, reflect.Slice:
+		case reflect.Struct, reflect.Ptr, reflect.Array, reflect.Slice, reflect.Map:
 			for i := 0; i < value.Len(); i++ {
-				errs = deepValidate(value.Index(i), errs)
+				errs = walkValue(value.Index(i), fmt.Sprintf("%s[%d]", path, i), strict, errs)
 			}
 			return errs
 		}
+	case reflect.Map:
+		switch value.Type().Elem().Kind() {
+		case reflect.Struct, reflect.Ptr, reflect.Array, reflect.Slice, reflect.Map:
+			for _, key := range value.MapKeys() {
+				errs = walkValue(value.MapIndex(key), mapKeyPath(path, key), strict, errs)
+			}
+			return errs
+		}
+	}
+
+	if strict {
+		return append(errs, ValidationError{Field: path, Err: ErrNotStruct})
 	}
+	return errs
+}
 
-	return append(errs, ValidationError{Err: ErrNotStruct})
+// mapKeyPath appends a map index, e.g. `["key"]`, to path.
+func mapKeyPath(path string, key reflect.Value) string {
+	if key.Kind() == reflect.String {
+		return fmt.Sprintf("%s[%q]", path, key.String())
+	}
+	return fmt.Sprintf("%s[%v]", path, key.Interface())
 }
 
-func validateStruct(value reflect.Value, errs ValidationErrors) ValidationErrors {
+func validateStruct(value reflect.Value, path string, errs ValidationErrors) ValidationErrors {
 	valueKind := value.Kind()
 	if valueKind == reflect.Ptr && !value.IsNil() {
-		return validateStruct(value.Elem(), errs)
+		return validateStruct(value.Elem(), path, errs)
 	}
 	if valueKind != reflect.Struct {
-		return append(errs, ValidationError{Err: ErrNotStruct})
+		return append(errs, ValidationError{Field: path, Err: ErrNotStruct})
 	}
 
 	valueType := value.Type()
+	structPath := path
+	if structPath == "" {
+		structPath = valueType.Name()
+	}
 	for i := 0; i < valueType.NumField(); i++ {
-		errs = validateField(valueType.Field(i), value.Field(i), errs)
+		errs = validateField(structPath, valueType.Field(i), value.Field(i), value, errs)
 	}
 
 	return errs
 }
 
-func validateField(fieldDefinition reflect.StructField, fieldValue reflect.Value, errs ValidationErrors) ValidationErrors {
-	tag := fieldDefinition.Tag.Get(tagName)
-	if tag == "" || tag == "-" {
-		return errs
+func validateField(structPath string, fieldDefinition reflect.StructField, fieldValue reflect.Value, parent reflect.Value, errs ValidationErrors) ValidationErrors {
+	fieldName := fieldDefinition.Name
+	if structPath != "" {
+		fieldName = structPath + "." + fieldName
 	}
 
-	if !fieldDefinition.Anonymous && fieldDefinition.PkgPath != "" {
-		return append(errs, ValidationError{Err: ErrValidateForUnexportedFields})
-	}
+	exported := fieldDefinition.Anonymous || fieldDefinition.PkgPath == ""
 
-	vfunc, err := parseValidateTag(tag)
-	if err != nil {
-		return append(errs, ValidationError{Err: err})
-	}
+	if tag := fieldDefinition.Tag.Get(tagName); tag != "" && tag != "-" {
+		if !exported {
+			return append(errs, ValidationError{Field: fieldName, Err: ErrValidateForUnexportedFields})
+		}
+
+		rules, omitEmpty, err := parseValidateTag(tag, fieldDefinition.Name)
+		if err != nil {
+			return append(errs, ValidationError{Field: fieldName, Err: err})
+		}
 
-	for fieldValue.Kind() == reflect.Ptr && !fieldValue.IsNil() {
-		fieldValue = fieldValue.Elem()
+		leafValue := fieldValue
+		for leafValue.Kind() == reflect.Ptr && !leafValue.IsNil() {
+			leafValue = leafValue.Elem()
+		}
+
+		// By default every rule runs against a zero value (including a nil
+		// pointer), whether or not the tag has a required rule. Only when the
+		// tag opts in with omitempty does a zero value run just the required
+		// and requiredif rules, skipping the others rather than rejecting an
+		// absent optional field.
+		isZero := omitEmpty && leafValue.IsZero()
+
+		for _, r := range rules {
+			if isZero && r.name != requiredTag && r.name != requiredIfTag {
+				continue
+			}
+			if err := r.fn(leafValue, parent); err != nil {
+				errs = append(errs, ValidationError{Field: fieldName, Rule: r.name, Err: err})
+			}
+		}
 	}
 
-	if err := vfunc(fieldValue); err != nil {
-		errs = append(errs, ValidationError{Err: err})
+	// Descend into a nested struct/slice/array/map even when the field
+	// itself carries no validate tag, the same way the root passed to
+	// Validate is walked. Unexported fields are skipped: reflect forbids
+	// Interface() on anything derived from one, which several rules need.
+	if exported {
+		errs = walkValue(fieldValue, fieldName, false, errs)
 	}
 
 	return errs
 }
 
-func parseValidateTag(validateTag string) (validationFunc, error) {
-	s := strings.SplitN(validateTag, ":", 2)
+// rule pairs a compiled validationFunc with the tag name it was parsed
+// from, so validateField can report which rule failed.
+type rule struct {
+	name string
+	fn   validationFunc
+}
+
+func parseValidateTag(validateTag string, fieldName string) ([]rule, bool, error) {
+	parts := strings.Split(validateTag, ruleSep)
+	rules := make([]rule, 0, len(parts))
+	omitEmpty := false
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		s := strings.SplitN(part, ":", 2)
+		name := strings.TrimSpace(s[0])
+		param := ""
+		if len(s) == 2 {
+			param = s[1]
+		}
+
+		if name == omitEmptyTag {
+			omitEmpty = true
+			continue
+		}
+
+		fn, err := getValidationFunc(name, param, fieldName)
+		if err != nil {
+			return nil, false, err
+		}
+
+		rules = append(rules, rule{name: name, fn: fn})
+	}
+
+	if len(rules) == 0 && !omitEmpty {
+		return nil, false, ErrInvalidValidatorSyntax
+	}
 
-	return getValidationFunc(s[0], s[1])
+	return rules, omitEmpty, nil
 }
@@ -0,0 +1,489 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type Even struct {
+	N int `validate:"even"`
+}
+
+func TestRegisterValidator(t *testing.T) {
+	RegisterValidator("even", func(param string) (ValidationFunc, error) {
+		return func(value reflect.Value, _ reflect.Value) error {
+			if value.Int()%2 != 0 {
+				return errors.New("must be even")
+			}
+			return nil
+		}, nil
+	})
+
+	if err := Validate(Even{N: 4}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	err := Validate(Even{N: 3})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].Rule != "even" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+type BadCustom struct {
+	Name string `validate:"alwaysbad"`
+}
+
+func TestRegisterValidator_FactoryErrorPropagates(t *testing.T) {
+	RegisterValidator("alwaysbad", func(param string) (ValidationFunc, error) {
+		return nil, ErrInvalidValidatorSyntax
+	})
+
+	err := Validate(BadCustom{Name: "x"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || !errors.Is(verrs[0].Err, ErrInvalidValidatorSyntax) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+type Emailish struct {
+	Address string `validate:"regexp:^.+@.+$"`
+}
+
+func TestValidate_Regexp(t *testing.T) {
+	if err := Validate(Emailish{Address: "a@b.com"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	err := Validate(Emailish{Address: "not-an-email"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].Rule != "regexp" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+type BadRegexp struct {
+	Address string `validate:"regexp:(unclosed"`
+}
+
+func TestValidate_Regexp_InvalidPattern(t *testing.T) {
+	err := Validate(BadRegexp{Address: "anything"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || !errors.Is(verrs[0].Err, ErrInvalidValidatorSyntax) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+type RegexpOnInt struct {
+	Code int `validate:"regexp:^[0-9]+$"`
+}
+
+func TestValidate_Regexp_RejectsNonStringKind(t *testing.T) {
+	err := Validate(RegexpOnInt{Code: 42})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].Rule != "regexp" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+type NumericKinds struct {
+	I8  int8    `validate:"min:5;max:10"`
+	I16 int16   `validate:"min:5;max:10"`
+	I32 int32   `validate:"min:5;max:10"`
+	I64 int64   `validate:"min:5;max:10"`
+	U   uint    `validate:"min:5;max:10"`
+	U8  uint8   `validate:"min:5;max:10"`
+	U16 uint16  `validate:"min:5;max:10"`
+	U32 uint32  `validate:"min:5;max:10"`
+	U64 uint64  `validate:"min:5;max:10"`
+	F32 float32 `validate:"min:5;max:10"`
+	F64 float64 `validate:"min:5;max:10"`
+}
+
+func TestValidate_MinMax_AllNumericKinds(t *testing.T) {
+	withinRange := NumericKinds{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7}
+	if err := Validate(withinRange); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	belowMin := NumericKinds{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1}
+	err := Validate(belowMin)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	if len(verrs) != 11 {
+		t.Fatalf("expected every numeric kind to fail min, got %d errors: %v", len(verrs), verrs)
+	}
+	for _, v := range verrs {
+		if v.Rule != "min" {
+			t.Errorf("unexpected rule for %s: %s", v.Field, v.Rule)
+		}
+	}
+
+	aboveMax := NumericKinds{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20}
+	err = Validate(aboveMax)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !errors.As(err, &verrs) || len(verrs) != 11 {
+		t.Fatalf("expected every numeric kind to fail max, got %v", err)
+	}
+	for _, v := range verrs {
+		if v.Rule != "max" {
+			t.Errorf("unexpected rule for %s: %s", v.Field, v.Rule)
+		}
+	}
+}
+
+type NumericLenAndIn struct {
+	Count uint32  `validate:"len:5"`
+	Ratio float64 `validate:"in:0.5,1.5,2.5"`
+}
+
+func TestValidate_LenAndIn_NumericKinds(t *testing.T) {
+	if err := Validate(NumericLenAndIn{Count: 5, Ratio: 1.5}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	err := Validate(NumericLenAndIn{Count: 6, Ratio: 9.9})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 2 {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if verrs[0].Rule != "len" || verrs[1].Rule != "in" {
+		t.Fatalf("unexpected errors: %+v", verrs)
+	}
+}
+
+type Simple struct {
+	Name string `validate:"min:3;max:10"`
+	Age  int    `validate:"min:18"`
+}
+
+func TestValidate_MultiRuleTag(t *testing.T) {
+	err := Validate(Simple{Name: "x", Age: 10})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	if len(verrs) != 2 {
+		t.Fatalf("expected 2 errors, got %d: %v", len(verrs), verrs)
+	}
+
+	for _, v := range verrs {
+		if v.Field == "" || v.Rule == "" {
+			t.Errorf("expected Field and Rule to be set, got %+v", v)
+		}
+	}
+	if verrs[0].Field != "Simple.Name" || verrs[0].Rule != "min" {
+		t.Errorf("unexpected first error: %+v", verrs[0])
+	}
+	if verrs[1].Field != "Simple.Age" || verrs[1].Rule != "min" {
+		t.Errorf("unexpected second error: %+v", verrs[1])
+	}
+}
+
+func TestValidate_MultiRuleTag_Passes(t *testing.T) {
+	if err := Validate(Simple{Name: "Alice", Age: 30}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+type Child struct {
+	X int `validate:"min:5"`
+}
+
+type Parent struct {
+	C Child
+	M map[string]Child
+	S []Child
+}
+
+func TestValidate_RecursesIntoNestedFields(t *testing.T) {
+	p := Parent{
+		C: Child{X: 1},
+		M: map[string]Child{"key": {X: 1}},
+		S: []Child{{X: 1}},
+	}
+
+	err := Validate(&p)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+
+	wantFields := map[string]bool{
+		`Parent.C.X`:        false,
+		`Parent.M["key"].X`: false,
+		`Parent.S[0].X`:     false,
+	}
+	for _, v := range verrs {
+		if _, ok := wantFields[v.Field]; !ok {
+			t.Errorf("unexpected field in errors: %s", v.Field)
+			continue
+		}
+		wantFields[v.Field] = true
+	}
+	for field, seen := range wantFields {
+		if !seen {
+			t.Errorf("expected an error for field %s, got none", field)
+		}
+	}
+}
+
+func TestValidate_RecursesIntoNestedFields_Passes(t *testing.T) {
+	p := Parent{
+		C: Child{X: 10},
+		M: map[string]Child{"key": {X: 10}},
+		S: []Child{{X: 10}},
+	}
+
+	if err := Validate(&p); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+type Optional struct {
+	Name string `validate:"required"`
+	Nick string `validate:"min:3"`
+}
+
+func TestValidate_RequiredDoesNotExemptOtherFields(t *testing.T) {
+	err := Validate(Optional{})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	if len(verrs) != 2 {
+		t.Fatalf("expected 2 errors (Name's required AND Nick's min, since Nick has no omitempty), got %d: %v", len(verrs), verrs)
+	}
+	if verrs[0].Field != "Optional.Name" || !errors.Is(verrs[0].Err, ErrRequired) {
+		t.Errorf("unexpected first error: %+v", verrs[0])
+	}
+	if verrs[1].Field != "Optional.Nick" || verrs[1].Rule != "min" {
+		t.Errorf("unexpected second error: %+v", verrs[1])
+	}
+}
+
+type OptionalNick struct {
+	Nick string `validate:"omitempty;min:3"`
+}
+
+func TestValidate_OmitEmptySkipsZeroValue(t *testing.T) {
+	if err := Validate(OptionalNick{}); err != nil {
+		t.Fatalf("expected omitempty to skip min on a zero value, got %v", err)
+	}
+
+	err := Validate(OptionalNick{Nick: "ab"})
+	if err == nil {
+		t.Fatal("expected error for a non-zero value that still fails min, got nil")
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].Rule != "min" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+type RequiredPointer struct {
+	Value *int `validate:"required"`
+}
+
+func TestValidate_RequiredRejectsNilPointer(t *testing.T) {
+	err := Validate(RequiredPointer{})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || !errors.Is(verrs[0].Err, ErrRequired) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	v := 5
+	if err := Validate(RequiredPointer{Value: &v}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+type Siblings struct {
+	Start int `validate:"required"`
+	End   int `validate:"gtfield:Start"`
+}
+
+func TestValidate_GtField(t *testing.T) {
+	if err := Validate(Siblings{Start: 1, End: 2}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	err := Validate(Siblings{Start: 5, End: 5})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	if len(verrs) != 1 || verrs[0].Field != "Siblings.End" || verrs[0].Rule != "gtfield" {
+		t.Fatalf("unexpected error: %+v", verrs)
+	}
+}
+
+func TestValidate_GtField_ZeroValueStillChecked(t *testing.T) {
+	err := Validate(Siblings{Start: 5, End: 0})
+	if err == nil {
+		t.Fatal("expected error: End's zero value must not exempt it from gtfield")
+	}
+
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	if len(verrs) != 1 || verrs[0].Field != "Siblings.End" || verrs[0].Rule != "gtfield" {
+		t.Fatalf("unexpected error: %+v", verrs)
+	}
+}
+
+type EqSiblings struct {
+	A string `validate:"eqfield:B"`
+	B string
+}
+
+func TestValidate_EqField_ZeroValueStillChecked(t *testing.T) {
+	err := Validate(EqSiblings{A: "", B: "set"})
+	if err == nil {
+		t.Fatal("expected error: A's zero value must not exempt it from eqfield")
+	}
+
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].Rule != "eqfield" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := Validate(EqSiblings{A: "", B: ""}); err != nil {
+		t.Fatalf("expected no error when both sides are zero, got %v", err)
+	}
+}
+
+type NeSiblings struct {
+	A string `validate:"nefield:B"`
+	B string
+}
+
+func TestValidate_NeField_ZeroValueStillChecked(t *testing.T) {
+	err := Validate(NeSiblings{A: "", B: ""})
+	if err == nil {
+		t.Fatal("expected error: A's zero value must not exempt it from nefield")
+	}
+
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || verrs[0].Rule != "nefield" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := Validate(NeSiblings{A: "x", B: ""}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+type MismatchedKinds struct {
+	Start string `validate:"required"`
+	End   int    `validate:"gtfield:Start"`
+}
+
+func TestValidate_GtField_KindMismatchNamesBothFields(t *testing.T) {
+	err := Validate(MismatchedKinds{Start: "a", End: 1})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	if len(verrs) != 1 {
+		t.Fatalf("expected exactly 1 error, got %d: %v", len(verrs), verrs)
+	}
+
+	got := verrs[0].Err.Error()
+	want := "field End is not comparable to field Start"
+	if got != want {
+		t.Errorf("got error %q, want %q", got, want)
+	}
+}
+
+type UnexportedSibling struct {
+	Public  string `validate:"eqfield:private"`
+	private string
+}
+
+func TestValidate_EqField_UnexportedSiblingErrors(t *testing.T) {
+	err := Validate(UnexportedSibling{Public: "x"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	if len(verrs) != 1 || !errors.Is(verrs[0].Err, ErrValidateForUnexportedFields) {
+		t.Fatalf("expected ErrValidateForUnexportedFields, got %+v", verrs)
+	}
+}
+
+type RequiredIf struct {
+	Kind  string `validate:"required"`
+	Value string `validate:"requiredif:Kind:special"`
+}
+
+func TestValidate_RequiredIf(t *testing.T) {
+	if err := Validate(RequiredIf{Kind: "plain"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	err := Validate(RequiredIf{Kind: "special"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	var verrs ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	if len(verrs) != 1 || verrs[0].Field != "RequiredIf.Value" || !errors.Is(verrs[0].Err, ErrRequired) {
+		t.Fatalf("unexpected error: %+v", verrs)
+	}
+}
@@ -0,0 +1,78 @@
+// Package validators registers a handful of commonly needed validate rules
+// (email, url, uuid, regexp) with the core validator package. It is opt-in:
+// import it for its side effect to make the rules available by name.
+//
+//	import _ "github.com/maypok86/validator/validators"
+package validators
+
+import (
+	"errors"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+
+	validator "github.com/maypok86/validator"
+)
+
+func init() {
+	validator.RegisterValidator("email", emailValidator)
+	validator.RegisterValidator("url", urlValidator)
+	validator.RegisterValidator("uuid", uuidValidator)
+	validator.RegisterValidator("regexp", regexpValidator)
+}
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func emailValidator(_ string) (validator.ValidationFunc, error) {
+	return func(value reflect.Value, _ reflect.Value) error {
+		if value.Kind() != reflect.String {
+			return errors.New("invalid type of field for tag email")
+		}
+		if _, err := mail.ParseAddress(value.String()); err != nil {
+			return errors.New("invalid email address")
+		}
+		return nil
+	}, nil
+}
+
+func urlValidator(_ string) (validator.ValidationFunc, error) {
+	return func(value reflect.Value, _ reflect.Value) error {
+		if value.Kind() != reflect.String {
+			return errors.New("invalid type of field for tag url")
+		}
+		u, err := url.ParseRequestURI(value.String())
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return errors.New("invalid url")
+		}
+		return nil
+	}, nil
+}
+
+func uuidValidator(_ string) (validator.ValidationFunc, error) {
+	return func(value reflect.Value, _ reflect.Value) error {
+		if value.Kind() != reflect.String {
+			return errors.New("invalid type of field for tag uuid")
+		}
+		if !uuidPattern.MatchString(value.String()) {
+			return errors.New("invalid uuid")
+		}
+		return nil
+	}, nil
+}
+
+func regexpValidator(param string) (validator.ValidationFunc, error) {
+	re, err := regexp.Compile(param)
+	if err != nil {
+		return nil, validator.ErrInvalidValidatorSyntax
+	}
+	return func(value reflect.Value, _ reflect.Value) error {
+		if value.Kind() != reflect.String {
+			return errors.New("invalid type of field for tag regexp")
+		}
+		if !re.MatchString(value.String()) {
+			return errors.New("string does not match pattern")
+		}
+		return nil
+	}, nil
+}
@@ -0,0 +1,76 @@
+package validators
+
+import (
+	"errors"
+	"testing"
+
+	validator "github.com/maypok86/validator"
+)
+
+type Contact struct {
+	Email string `validate:"email"`
+	Site  string `validate:"url"`
+	ID    string `validate:"uuid"`
+	Code  string `validate:"regexp:^[A-Z]{3}$"`
+}
+
+func TestValidators_AllPass(t *testing.T) {
+	c := Contact{
+		Email: "a@b.com",
+		Site:  "https://example.com",
+		ID:    "123e4567-e89b-12d3-a456-426614174000",
+		Code:  "ABC",
+	}
+	if err := validator.Validate(c); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestValidators_AllFail(t *testing.T) {
+	c := Contact{
+		Email: "not-an-email",
+		Site:  "not-a-url",
+		ID:    "not-a-uuid",
+		Code:  "not-matching",
+	}
+
+	err := validator.Validate(c)
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	if len(verrs) != 4 {
+		t.Fatalf("expected all 4 fields to fail, got %d errors: %v", len(verrs), verrs)
+	}
+}
+
+type WrongKind struct {
+	Email int `validate:"email"`
+}
+
+func TestEmailValidator_RejectsNonStringKind(t *testing.T) {
+	err := validator.Validate(WrongKind{Email: 1})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+}
+
+type BadRegexpParam struct {
+	Code string `validate:"regexp:(unclosed"`
+}
+
+func TestRegexpValidator_InvalidPattern(t *testing.T) {
+	err := validator.Validate(BadRegexpParam{Code: "x"})
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) || len(verrs) != 1 || !errors.Is(verrs[0].Err, validator.ErrInvalidValidatorSyntax) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}